@@ -0,0 +1,153 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Comment struct {
+	ID       int    `json:"id,omitempty"`
+	Text     string `json:"text,omitempty"`
+	PersonID int    `json:"person_id,omitempty"`
+	// CreatedAt and UpdatedAt are Tracker's timestamps for the comment.
+	// They're populated on responses but left nil on outbound requests so a
+	// freshly-built Comment{Text: ...} doesn't ship a zero-value timestamp.
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	FileAttachmentIDs   []int      `json:"file_attachment_ids,omitempty"`
+	GoogleAttachmentIDs []int      `json:"google_attachment_ids,omitempty"`
+}
+
+// CommentPatch carries the fields to change on an existing Comment.
+type CommentPatch struct {
+	Text *string `json:"text,omitempty"`
+}
+
+type CommentsQuery struct {
+	Offset int
+	Limit  int
+}
+
+func (q CommentsQuery) Query() url.Values {
+	params := url.Values{}
+
+	if q.Offset != 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+
+	if q.Limit != 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return params
+}
+
+// CommentAttachment is a file to be uploaded alongside a comment as a
+// files[] multipart form part.
+type CommentAttachment struct {
+	Filename string
+	Content  io.Reader
+}
+
+func (p ProjectClient) StoryComments(ctx context.Context, storyId int, query CommentsQuery) ([]Comment, Pagination, error) {
+	url := fmt.Sprintf("/stories/%d/comments", storyId)
+	request, err := p.createRequest(ctx, "GET", url, query.Query())
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	var comments []Comment
+	pagination, err := p.conn.Do(request, &comments)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return comments, pagination, err
+}
+
+func (p ProjectClient) CreateStoryComment(ctx context.Context, storyId int, c Comment, attachments ...CommentAttachment) (Comment, error) {
+	url := fmt.Sprintf("/stories/%d/comments", storyId)
+	request, err := p.createRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	if len(attachments) == 0 {
+		buffer := &bytes.Buffer{}
+		json.NewEncoder(buffer).Encode(c)
+
+		p.addJSONBodyReader(request, buffer)
+	} else {
+		buffer := &bytes.Buffer{}
+		writer := multipart.NewWriter(buffer)
+
+		if c.Text != "" {
+			if err := writer.WriteField("text", c.Text); err != nil {
+				return Comment{}, err
+			}
+		}
+
+		for _, attachment := range attachments {
+			part, err := writer.CreateFormFile("files[]", attachment.Filename)
+			if err != nil {
+				return Comment{}, err
+			}
+
+			if _, err := io.Copy(part, attachment.Content); err != nil {
+				return Comment{}, err
+			}
+		}
+
+		if err := writer.Close(); err != nil {
+			return Comment{}, err
+		}
+
+		data := buffer.Bytes()
+		request.ContentLength = int64(len(data))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(data)), nil
+		}
+		request.Body, _ = request.GetBody()
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+	}
+
+	var createdComment Comment
+	_, err = p.conn.Do(request, &createdComment)
+	return createdComment, err
+}
+
+func (p ProjectClient) UpdateStoryComment(ctx context.Context, storyId int, commentId int, patch CommentPatch) (Comment, error) {
+	url := fmt.Sprintf("/stories/%d/comments/%d", storyId, commentId)
+	request, err := p.createRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return Comment{}, err
+	}
+
+	buffer := &bytes.Buffer{}
+	json.NewEncoder(buffer).Encode(patch)
+
+	p.addJSONBodyReader(request, buffer)
+
+	var updatedComment Comment
+	_, err = p.conn.Do(request, &updatedComment)
+	return updatedComment, err
+}
+
+func (p ProjectClient) DeleteStoryComment(ctx context.Context, storyId int, commentId int) error {
+	url := fmt.Sprintf("/stories/%d/comments/%d", storyId, commentId)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.conn.Do(request, nil)
+	return err
+}
@@ -0,0 +1,310 @@
+package tracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const defaultBaseURL = "https://www.pivotaltracker.com/services/v5"
+const defaultMaxRetries = 3
+
+// ClientConfig configures how a Client authenticates and makes HTTP calls to
+// the Tracker API. Exactly one of APIToken or TokenSource must be set.
+type ClientConfig struct {
+	APIToken    string
+	TokenSource oauth2.TokenSource
+	HTTPClient  *http.Client
+
+	// BaseURL overrides the Tracker API root, e.g. to point at an
+	// httptest.Server in tests. Defaults to the production API.
+	BaseURL string
+
+	// MaxRetries caps the total number of attempts, the first request
+	// included, made against 429 or 5xx responses. Defaults to 3 when nil;
+	// a pointer to 0 or 1 disables retries entirely.
+	MaxRetries *int
+
+	// RetryPOST allows POST requests (CreateStory, CreateStoryComment, ...)
+	// to be retried. Off by default since a retried POST can create
+	// duplicates if the original request actually succeeded server-side.
+	RetryPOST bool
+}
+
+// Client is the entry point for the Tracker API. Use InProject to scope
+// calls to a particular project.
+type Client struct {
+	conn connection
+}
+
+// NewClient builds a Client from cfg. It fails unless exactly one of
+// APIToken or TokenSource was supplied.
+func NewClient(cfg ClientConfig) (*Client, error) {
+	if cfg.APIToken == "" && cfg.TokenSource == nil {
+		return nil, fmt.Errorf("tracker: ClientConfig requires an APIToken or a TokenSource")
+	}
+
+	if cfg.APIToken != "" && cfg.TokenSource != nil {
+		return nil, fmt.Errorf("tracker: ClientConfig requires only one of APIToken or TokenSource")
+	}
+
+	return &Client{conn: newConnection(cfg)}, nil
+}
+
+// InProject scopes the client to a single Tracker project.
+func (c *Client) InProject(id int) ProjectClient {
+	return ProjectClient{id: id, conn: &c.conn}
+}
+
+// SetHTTPClient swaps in a custom *http.Client, e.g. to add retries, tracing,
+// or to point at an httptest.Server in tests.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.conn.SetHTTPClient(httpClient)
+}
+
+// RateLimit reports Tracker's per-minute request budget as of the most
+// recently observed response.
+func (c *Client) RateLimit() RateLimit {
+	return c.conn.RateLimit()
+}
+
+// RateLimit is a snapshot of Tracker's per-minute request budget, parsed
+// from the X-RateLimit-Remaining / X-RateLimit-Reset response headers.
+type RateLimit struct {
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimitError is returned once MaxRetries has been exhausted against a
+// 429 or 5xx response, or after the first such response when the request
+// is not eligible for retry (see shouldRetry).
+type RateLimitError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("tracker: giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+type connection struct {
+	baseURL     string
+	apiToken    string
+	tokenSource oauth2.TokenSource
+	httpClient  *http.Client
+	maxRetries  int
+	retryPOST   bool
+
+	rateLimitMu sync.Mutex
+	rateLimit   RateLimit
+}
+
+func newConnection(cfg ClientConfig) connection {
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	maxRetries := defaultMaxRetries
+	if cfg.MaxRetries != nil {
+		maxRetries = *cfg.MaxRetries
+	}
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	return connection{
+		baseURL:     baseURL,
+		apiToken:    cfg.APIToken,
+		tokenSource: cfg.TokenSource,
+		httpClient:  httpClient,
+		maxRetries:  maxRetries,
+		retryPOST:   cfg.RetryPOST,
+	}
+}
+
+func (c *connection) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+func (c *connection) CreateRequest(method string, path string, params url.Values) (*http.Request, error) {
+	requestURL := c.baseURL + path
+	if len(params) > 0 {
+		requestURL += "?" + params.Encode()
+	}
+
+	request, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.authenticate(request); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func (c *connection) authenticate(request *http.Request) error {
+	if c.tokenSource != nil {
+		token, err := c.tokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("tracker: fetching OAuth2 token: %w", err)
+		}
+
+		token.SetAuthHeader(request)
+		return nil
+	}
+
+	request.Header.Add("X-TrackerToken", c.apiToken)
+	return nil
+}
+
+// Pagination describes Tracker's offset/limit paging window, as reported by
+// the X-Tracker-Pagination-* response headers.
+type Pagination struct {
+	Total  int
+	Offset int
+	Limit  int
+}
+
+func paginationFromHeaders(header http.Header) Pagination {
+	total, _ := strconv.Atoi(header.Get("X-Tracker-Pagination-Total"))
+	offset, _ := strconv.Atoi(header.Get("X-Tracker-Pagination-Offset"))
+	limit, _ := strconv.Atoi(header.Get("X-Tracker-Pagination-Limit"))
+
+	return Pagination{Total: total, Offset: offset, Limit: limit}
+}
+
+// RateLimit returns the most recently observed per-minute request budget.
+func (c *connection) RateLimit() RateLimit {
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	return c.rateLimit
+}
+
+func (c *connection) recordRateLimit(response *http.Response) {
+	remaining, err := strconv.Atoi(response.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	reset, err := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	c.rateLimitMu.Lock()
+	defer c.rateLimitMu.Unlock()
+	c.rateLimit = RateLimit{Remaining: remaining, Reset: time.Unix(reset, 0)}
+}
+
+// Do executes request, decoding a successful response body into v (if v is
+// non-nil), and transparently retries 429/5xx responses of requests that
+// shouldRetry allows, honoring Retry-After and falling back to exponential
+// backoff with jitter.
+func (c *connection) Do(request *http.Request, v interface{}) (Pagination, error) {
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		response, err := c.httpClient.Do(cloneRequest(request))
+		if err != nil {
+			return Pagination{}, err
+		}
+
+		c.recordRateLimit(response)
+
+		if response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError {
+			lastErr = fmt.Errorf("tracker: %s %s returned %s", request.Method, request.URL, response.Status)
+			response.Body.Close()
+
+			if !c.shouldRetry(request, attempt) {
+				return Pagination{}, &RateLimitError{Attempts: attempt + 1, Err: lastErr}
+			}
+
+			timer := time.NewTimer(retryDelay(response, attempt))
+			select {
+			case <-request.Context().Done():
+				timer.Stop()
+				return Pagination{}, request.Context().Err()
+			case <-timer.C:
+			}
+
+			continue
+		}
+
+		defer response.Body.Close()
+
+		if response.StatusCode >= http.StatusBadRequest {
+			return Pagination{}, fmt.Errorf("tracker: %s %s returned %s", request.Method, request.URL, response.Status)
+		}
+
+		if v != nil {
+			if err := json.NewDecoder(response.Body).Decode(v); err != nil && err != io.EOF {
+				return Pagination{}, err
+			}
+		}
+
+		return paginationFromHeaders(response.Header), nil
+	}
+}
+
+// shouldRetry reports whether attempt (0-indexed) may be followed by
+// another attempt for request. GET/DELETE are always retried; PUT/POST are
+// only retried when the request body can be replayed, and POST additionally
+// requires the caller to have opted in via ClientConfig.RetryPOST.
+func (c *connection) shouldRetry(request *http.Request, attempt int) bool {
+	if attempt+1 >= c.maxRetries {
+		return false
+	}
+
+	switch request.Method {
+	case http.MethodGet, http.MethodDelete:
+		return true
+	case http.MethodPut:
+		return request.GetBody != nil
+	case http.MethodPost:
+		return c.retryPOST && request.GetBody != nil
+	default:
+		return false
+	}
+}
+
+func retryDelay(response *http.Response, attempt int) time.Duration {
+	if retryAfter := response.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	backoff := time.Duration(1<<uint(attempt)) * 100 * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(backoff)/2 + 1))
+
+	return backoff + jitter
+}
+
+func cloneRequest(request *http.Request) *http.Request {
+	clone := request.Clone(request.Context())
+
+	if request.GetBody != nil {
+		if body, err := request.GetBody(); err == nil {
+			clone.Body = body
+		}
+	}
+
+	return clone
+}
@@ -0,0 +1,167 @@
+package tracker
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func testConnection(t *testing.T, ts *httptest.Server, maxRetries int) *connection {
+	t.Helper()
+
+	conn := newConnection(ClientConfig{
+		APIToken:   "token",
+		BaseURL:    ts.URL,
+		MaxRetries: &maxRetries,
+	})
+	return &conn
+}
+
+func TestDoRetriesUntilMaxRetriesThenReturnsRateLimitError(t *testing.T) {
+	var attempts int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	conn := testConnection(t, ts, 3)
+
+	request, err := conn.CreateRequest("GET", "/stories", nil)
+	if err != nil {
+		t.Fatalf("CreateRequest: %v", err)
+	}
+
+	_, err = conn.Do(request, nil)
+
+	rateLimitErr, ok := err.(*RateLimitError)
+	if !ok {
+		t.Fatalf("expected *RateLimitError, got %v", err)
+	}
+
+	if rateLimitErr.Attempts != 3 {
+		t.Errorf("Attempts = %d, want 3", rateLimitErr.Attempts)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("server saw %d requests, want 3", got)
+	}
+}
+
+func TestDoMaxRetriesZeroDisablesRetries(t *testing.T) {
+	var attempts int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	conn := testConnection(t, ts, 0)
+
+	request, err := conn.CreateRequest("GET", "/stories", nil)
+	if err != nil {
+		t.Fatalf("CreateRequest: %v", err)
+	}
+
+	_, err = conn.Do(request, nil)
+
+	rateLimitErr, ok := err.(*RateLimitError)
+	if !ok {
+		t.Fatalf("expected *RateLimitError, got %v", err)
+	}
+
+	if rateLimitErr.Attempts != 1 {
+		t.Errorf("Attempts = %d, want 1", rateLimitErr.Attempts)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("server saw %d requests, want 1", got)
+	}
+}
+
+func TestDoHonorsRetryAfterThenSucceeds(t *testing.T) {
+	var attempts int32
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Write([]byte("[]"))
+	}))
+	defer ts.Close()
+
+	conn := testConnection(t, ts, 3)
+
+	request, err := conn.CreateRequest("GET", "/stories", nil)
+	if err != nil {
+		t.Fatalf("CreateRequest: %v", err)
+	}
+
+	var stories []Story
+	if _, err := conn.Do(request, &stories); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("server saw %d requests, want 2", got)
+	}
+}
+
+func replayableRequest(method string) *http.Request {
+	request, _ := http.NewRequest(method, "http://example.com", strings.NewReader("{}"))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader("{}")), nil
+	}
+	return request
+}
+
+func TestShouldRetryMethodEligibility(t *testing.T) {
+	conn := &connection{maxRetries: 3}
+	retryablePOSTConn := &connection{maxRetries: 3, retryPOST: true}
+
+	cases := []struct {
+		name string
+		conn *connection
+		req  *http.Request
+		want bool
+	}{
+		{"GET", conn, func() *http.Request { r, _ := http.NewRequest(http.MethodGet, "http://example.com", nil); return r }(), true},
+		{"DELETE", conn, func() *http.Request { r, _ := http.NewRequest(http.MethodDelete, "http://example.com", nil); return r }(), true},
+		{"PUT without replayable body", conn, func() *http.Request { r, _ := http.NewRequest(http.MethodPut, "http://example.com", nil); return r }(), false},
+		{"PUT with replayable body", conn, replayableRequest(http.MethodPut), true},
+		{"POST without RetryPOST", conn, replayableRequest(http.MethodPost), false},
+		{"POST with RetryPOST", retryablePOSTConn, replayableRequest(http.MethodPost), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.conn.shouldRetry(tc.req, 0); got != tc.want {
+				t.Errorf("shouldRetry() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShouldRetryStopsAtMaxRetries(t *testing.T) {
+	conn := &connection{maxRetries: 2}
+
+	request, _ := http.NewRequest(http.MethodGet, "http://example.com", nil)
+
+	if !conn.shouldRetry(request, 0) {
+		t.Error("attempt 0 of 2 max retries should retry")
+	}
+
+	if conn.shouldRetry(request, 1) {
+		t.Error("attempt 1 of 2 max retries should not retry")
+	}
+}
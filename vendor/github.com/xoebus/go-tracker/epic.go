@@ -0,0 +1,177 @@
+package tracker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type Epic struct {
+	ID          int    `json:"id,omitempty"`
+	ProjectID   int    `json:"project_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	LabelID     int    `json:"label_id,omitempty"`
+	// Label is Tracker's expansion of LabelID; it's populated on responses
+	// but left nil on outbound requests so it's never serialized.
+	Label      *Label `json:"label,omitempty"`
+	CommentIDs []int  `json:"comment_ids,omitempty"`
+	URL        string `json:"url,omitempty"`
+	AfterID    int    `json:"after_id,omitempty"`
+	BeforeID   int    `json:"before_id,omitempty"`
+}
+
+// EpicPatch carries the fields to change on an existing Epic. Only fields
+// that are set are sent, so a zero-value EpicPatch leaves the epic alone.
+type EpicPatch struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	LabelID     *int    `json:"label_id,omitempty"`
+	AfterID     *int    `json:"after_id,omitempty"`
+	BeforeID    *int    `json:"before_id,omitempty"`
+}
+
+// EpicsQuery supports paging through a project's epics the same way
+// LabelsQuery and MembershipsQuery page through labels and memberships.
+type EpicsQuery struct {
+	Offset int
+	Limit  int
+}
+
+func (q EpicsQuery) Query() url.Values {
+	params := url.Values{}
+
+	if q.Offset != 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+
+	if q.Limit != 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return params
+}
+
+func (p ProjectClient) Epics(ctx context.Context, query EpicsQuery) ([]Epic, Pagination, error) {
+	request, err := p.createRequest(ctx, "GET", "/epics", query.Query())
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	var epics []Epic
+	pagination, err := p.conn.Do(request, &epics)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return epics, pagination, err
+}
+
+func (p ProjectClient) CreateEpic(ctx context.Context, epic Epic) (Epic, error) {
+	request, err := p.createRequest(ctx, "POST", "/epics", nil)
+	if err != nil {
+		return Epic{}, err
+	}
+
+	buffer := &bytes.Buffer{}
+	json.NewEncoder(buffer).Encode(epic)
+
+	p.addJSONBodyReader(request, buffer)
+
+	var createdEpic Epic
+	_, err = p.conn.Do(request, &createdEpic)
+	return createdEpic, err
+}
+
+func (p ProjectClient) UpdateEpic(ctx context.Context, epicId int, patch EpicPatch) (Epic, error) {
+	url := fmt.Sprintf("/epics/%d", epicId)
+	request, err := p.createRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return Epic{}, err
+	}
+
+	buffer := &bytes.Buffer{}
+	json.NewEncoder(buffer).Encode(patch)
+
+	p.addJSONBodyReader(request, buffer)
+
+	var updatedEpic Epic
+	_, err = p.conn.Do(request, &updatedEpic)
+	return updatedEpic, err
+}
+
+func (p ProjectClient) DeleteEpic(ctx context.Context, epicId int) error {
+	url := fmt.Sprintf("/epics/%d", epicId)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.conn.Do(request, nil)
+	return err
+}
+
+func (p ProjectClient) EpicComments(ctx context.Context, epicId int, query CommentsQuery) ([]Comment, Pagination, error) {
+	url := fmt.Sprintf("/epics/%d/comments", epicId)
+	request, err := p.createRequest(ctx, "GET", url, query.Query())
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	var comments []Comment
+	pagination, err := p.conn.Do(request, &comments)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return comments, pagination, err
+}
+
+func (p ProjectClient) EpicActivity(ctx context.Context, epicId int, query ActivityQuery) ([]Activity, Pagination, error) {
+	url := fmt.Sprintf("/epics/%d/activity", epicId)
+	request, err := p.createRequest(ctx, "GET", url, query.Query())
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	var activities []Activity
+	pagination, err := p.conn.Do(request, &activities)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return activities, pagination, err
+}
+
+func (p ProjectClient) AddEpicLabel(ctx context.Context, epicId int, label string) (Label, error) {
+	url := fmt.Sprintf("/epics/%d/labels", epicId)
+	request, err := p.createRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return Label{}, err
+	}
+
+	reqJSON, err := json.Marshal(Label{Name: label})
+	if err != nil {
+		return Label{}, err
+	}
+
+	p.addJSONBody(request, string(reqJSON))
+
+	var createdLabel Label
+	_, err = p.conn.Do(request, &createdLabel)
+	return createdLabel, err
+}
+
+func (p ProjectClient) RemoveEpicLabel(ctx context.Context, epicId int, labelId int) error {
+	url := fmt.Sprintf("/epics/%d/labels/%d", epicId, labelId)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.conn.Do(request, nil)
+	return err
+}
@@ -0,0 +1,371 @@
+package tracker
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+const defaultIterationPerPage = 100
+
+// LabelsQuery supports paging through a project's labels the same way
+// StoriesQuery and ActivityQuery page through stories and activity.
+type LabelsQuery struct {
+	Offset int
+	Limit  int
+}
+
+func (q LabelsQuery) Query() url.Values {
+	params := url.Values{}
+
+	if q.Offset != 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+
+	if q.Limit != 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return params
+}
+
+// MembershipsQuery supports paging through a project's memberships.
+type MembershipsQuery struct {
+	Offset int
+	Limit  int
+}
+
+func (q MembershipsQuery) Query() url.Values {
+	params := url.Values{}
+
+	if q.Offset != 0 {
+		params.Set("offset", strconv.Itoa(q.Offset))
+	}
+
+	if q.Limit != 0 {
+		params.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	return params
+}
+
+// pageFetcher retrieves one page of T starting at offset, sized to limit.
+type pageFetcher[T any] func(ctx context.Context, offset int, limit int) ([]T, error)
+
+// pageIterator is the shared offset/limit walking state machine behind
+// StoryIterator, ActivityIterator, LabelIterator and MembershipIterator. It
+// buffers one fetched page at a time, filters out items the caller doesn't
+// want, and asks for another page once the buffer runs dry.
+type pageIterator[T any] struct {
+	ctx     context.Context
+	fetch   pageFetcher[T]
+	perPage int
+	max     int
+	filter  func(T) bool
+
+	buffer  []T
+	current T
+	offset  int
+	seen    int
+	done    bool
+	err     error
+}
+
+func newPageIterator[T any](ctx context.Context, startOffset int, perPage int, max int, filter func(T) bool, fetch pageFetcher[T]) *pageIterator[T] {
+	if perPage <= 0 {
+		perPage = defaultIterationPerPage
+	}
+
+	return &pageIterator[T]{ctx: ctx, fetch: fetch, perPage: perPage, max: max, filter: filter, offset: startOffset}
+}
+
+func (it *pageIterator[T]) Next() bool {
+	if it.err != nil || (it.done && len(it.buffer) == 0) {
+		return false
+	}
+
+	if it.max > 0 && it.seen >= it.max {
+		it.done = true
+		return false
+	}
+
+	for len(it.buffer) == 0 {
+		items, err := it.fetch(it.ctx, it.offset, it.perPage)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		if len(items) == 0 {
+			it.done = true
+			return false
+		}
+
+		it.offset += len(items)
+
+		// A short page is authoritative end-of-data from Tracker regardless
+		// of how many items survive the filter, so mark done now instead of
+		// waiting for an empty page to confirm it on the next call.
+		if len(items) < it.perPage {
+			it.done = true
+		}
+
+		for _, item := range items {
+			if it.filter != nil && !it.filter(item) {
+				continue
+			}
+			it.buffer = append(it.buffer, item)
+		}
+
+		if it.done && len(it.buffer) == 0 {
+			return false
+		}
+	}
+
+	it.current, it.buffer = it.buffer[0], it.buffer[1:]
+	it.seen++
+
+	return true
+}
+
+func (it *pageIterator[T]) Item() T {
+	return it.current
+}
+
+func (it *pageIterator[T]) Err() error {
+	return it.err
+}
+
+// StoriesOptions controls ProjectClient.AllStories and ProjectClient.IterStories.
+type StoriesOptions struct {
+	PerPage int
+	Max     int
+	Filter  func(Story) bool
+}
+
+// AllStories walks every page of Stories until Tracker runs out of stories or
+// opts.Max is reached, returning the accumulated result in one slice.
+func (p ProjectClient) AllStories(ctx context.Context, query StoriesQuery, opts StoriesOptions) ([]Story, error) {
+	var stories []Story
+
+	iter := p.IterStories(ctx, query, opts)
+	for iter.Next() {
+		stories = append(stories, iter.Story())
+	}
+
+	return stories, iter.Err()
+}
+
+// StoryIterator walks Stories one story at a time, issuing additional pages
+// as the caller drains the current one.
+type StoryIterator struct {
+	inner *pageIterator[Story]
+}
+
+// IterStories returns a StoryIterator over query, paging with opts.PerPage
+// and stopping once opts.Max stories have been returned (0 means no limit).
+// If query.Offset is set, paging resumes from that offset instead of 0.
+func (p ProjectClient) IterStories(ctx context.Context, query StoriesQuery, opts StoriesOptions) *StoryIterator {
+	fetch := func(ctx context.Context, offset int, limit int) ([]Story, error) {
+		pageQuery := query
+		pageQuery.Offset = offset
+		pageQuery.Limit = limit
+
+		stories, _, err := p.Stories(ctx, pageQuery)
+		return stories, err
+	}
+
+	return &StoryIterator{inner: newPageIterator(ctx, query.Offset, opts.PerPage, opts.Max, opts.Filter, fetch)}
+}
+
+func (it *StoryIterator) Next() bool {
+	return it.inner.Next()
+}
+
+func (it *StoryIterator) Story() Story {
+	return it.inner.Item()
+}
+
+func (it *StoryIterator) Err() error {
+	return it.inner.Err()
+}
+
+// ActivityOptions controls ProjectClient.AllStoryActivity and
+// ProjectClient.IterStoryActivity.
+type ActivityOptions struct {
+	PerPage int
+	Max     int
+	Filter  func(Activity) bool
+}
+
+func (p ProjectClient) AllStoryActivity(ctx context.Context, storyId int, query ActivityQuery, opts ActivityOptions) ([]Activity, error) {
+	var activities []Activity
+
+	iter := p.IterStoryActivity(ctx, storyId, query, opts)
+	for iter.Next() {
+		activities = append(activities, iter.Activity())
+	}
+
+	return activities, iter.Err()
+}
+
+type ActivityIterator struct {
+	inner *pageIterator[Activity]
+}
+
+// IterStoryActivity returns an ActivityIterator over query, resuming from
+// query.Offset instead of 0 when the caller has set it.
+func (p ProjectClient) IterStoryActivity(ctx context.Context, storyId int, query ActivityQuery, opts ActivityOptions) *ActivityIterator {
+	fetch := func(ctx context.Context, offset int, limit int) ([]Activity, error) {
+		pageQuery := query
+		pageQuery.Offset = offset
+		pageQuery.Limit = limit
+
+		activities, _, err := p.StoryActivity(ctx, storyId, pageQuery)
+		return activities, err
+	}
+
+	return &ActivityIterator{inner: newPageIterator(ctx, query.Offset, opts.PerPage, opts.Max, opts.Filter, fetch)}
+}
+
+func (it *ActivityIterator) Next() bool {
+	return it.inner.Next()
+}
+
+func (it *ActivityIterator) Activity() Activity {
+	return it.inner.Item()
+}
+
+func (it *ActivityIterator) Err() error {
+	return it.inner.Err()
+}
+
+// LabelsOptions controls ProjectClient.AllLabels and ProjectClient.IterLabels.
+type LabelsOptions struct {
+	PerPage int
+	Max     int
+	Filter  func(Label) bool
+}
+
+func (p ProjectClient) AllLabels(ctx context.Context, opts LabelsOptions) ([]Label, error) {
+	var labels []Label
+
+	iter := p.IterLabels(ctx, opts)
+	for iter.Next() {
+		labels = append(labels, iter.Label())
+	}
+
+	return labels, iter.Err()
+}
+
+type LabelIterator struct {
+	inner *pageIterator[Label]
+}
+
+func (p ProjectClient) IterLabels(ctx context.Context, opts LabelsOptions) *LabelIterator {
+	fetch := func(ctx context.Context, offset int, limit int) ([]Label, error) {
+		labels, _, err := p.Labels(ctx, LabelsQuery{Offset: offset, Limit: limit})
+		return labels, err
+	}
+
+	return &LabelIterator{inner: newPageIterator(ctx, 0, opts.PerPage, opts.Max, opts.Filter, fetch)}
+}
+
+func (it *LabelIterator) Next() bool {
+	return it.inner.Next()
+}
+
+func (it *LabelIterator) Label() Label {
+	return it.inner.Item()
+}
+
+func (it *LabelIterator) Err() error {
+	return it.inner.Err()
+}
+
+// EpicsOptions controls ProjectClient.AllEpics and ProjectClient.IterEpics.
+type EpicsOptions struct {
+	PerPage int
+	Max     int
+	Filter  func(Epic) bool
+}
+
+func (p ProjectClient) AllEpics(ctx context.Context, opts EpicsOptions) ([]Epic, error) {
+	var epics []Epic
+
+	iter := p.IterEpics(ctx, opts)
+	for iter.Next() {
+		epics = append(epics, iter.Epic())
+	}
+
+	return epics, iter.Err()
+}
+
+type EpicIterator struct {
+	inner *pageIterator[Epic]
+}
+
+func (p ProjectClient) IterEpics(ctx context.Context, opts EpicsOptions) *EpicIterator {
+	fetch := func(ctx context.Context, offset int, limit int) ([]Epic, error) {
+		epics, _, err := p.Epics(ctx, EpicsQuery{Offset: offset, Limit: limit})
+		return epics, err
+	}
+
+	return &EpicIterator{inner: newPageIterator(ctx, 0, opts.PerPage, opts.Max, opts.Filter, fetch)}
+}
+
+func (it *EpicIterator) Next() bool {
+	return it.inner.Next()
+}
+
+func (it *EpicIterator) Epic() Epic {
+	return it.inner.Item()
+}
+
+func (it *EpicIterator) Err() error {
+	return it.inner.Err()
+}
+
+// MembershipsOptions controls ProjectClient.AllProjectMemberships and
+// ProjectClient.IterProjectMemberships.
+type MembershipsOptions struct {
+	PerPage int
+	Max     int
+	Filter  func(ProjectMembership) bool
+}
+
+func (p ProjectClient) AllProjectMemberships(ctx context.Context, opts MembershipsOptions) ([]ProjectMembership, error) {
+	var memberships []ProjectMembership
+
+	iter := p.IterProjectMemberships(ctx, opts)
+	for iter.Next() {
+		memberships = append(memberships, iter.ProjectMembership())
+	}
+
+	return memberships, iter.Err()
+}
+
+type MembershipIterator struct {
+	inner *pageIterator[ProjectMembership]
+}
+
+func (p ProjectClient) IterProjectMemberships(ctx context.Context, opts MembershipsOptions) *MembershipIterator {
+	fetch := func(ctx context.Context, offset int, limit int) ([]ProjectMembership, error) {
+		memberships, _, err := p.ProjectMemberships(ctx, MembershipsQuery{Offset: offset, Limit: limit})
+		return memberships, err
+	}
+
+	return &MembershipIterator{inner: newPageIterator(ctx, 0, opts.PerPage, opts.Max, opts.Filter, fetch)}
+}
+
+func (it *MembershipIterator) Next() bool {
+	return it.inner.Next()
+}
+
+func (it *MembershipIterator) ProjectMembership() ProjectMembership {
+	return it.inner.Item()
+}
+
+func (it *MembershipIterator) Err() error {
+	return it.inner.Err()
+}
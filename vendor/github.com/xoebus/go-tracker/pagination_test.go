@@ -0,0 +1,123 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+)
+
+// fakePages serves fixed-size pages from a canned slice, recording every
+// offset/limit it's asked for so tests can assert on fetch behavior.
+type fakePages struct {
+	items   []int
+	fetches [][2]int
+}
+
+func (f *fakePages) fetch(ctx context.Context, offset int, limit int) ([]int, error) {
+	f.fetches = append(f.fetches, [2]int{offset, limit})
+
+	if offset >= len(f.items) {
+		return nil, nil
+	}
+
+	end := offset + limit
+	if end > len(f.items) {
+		end = len(f.items)
+	}
+
+	return f.items[offset:end], nil
+}
+
+func drain(it *pageIterator[int]) ([]int, error) {
+	var got []int
+	for it.Next() {
+		got = append(got, it.Item())
+	}
+	return got, it.Err()
+}
+
+func TestPageIteratorWalksAllPages(t *testing.T) {
+	pages := &fakePages{items: []int{1, 2, 3, 4, 5}}
+
+	it := newPageIterator(context.Background(), 0, 2, 0, nil, pages.fetch)
+
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+	if !equalInts(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPageIteratorSeedsStartOffsetFromCaller(t *testing.T) {
+	pages := &fakePages{items: []int{1, 2, 3, 4, 5}}
+
+	it := newPageIterator(context.Background(), 3, 2, 0, nil, pages.fetch)
+
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{4, 5}
+	if !equalInts(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if pages.fetches[0][0] != 3 {
+		t.Errorf("first fetch offset = %d, want 3", pages.fetches[0][0])
+	}
+}
+
+func TestPageIteratorStopsOnShortPageWithSurvivingFilter(t *testing.T) {
+	pages := &fakePages{items: []int{1, 2, 3}}
+
+	// perPage=2 means the second page ([3]) is short; the lone item still
+	// survives the filter, but this must still be the terminal fetch.
+	it := newPageIterator(context.Background(), 0, 2, 0, func(i int) bool { return true }, pages.fetch)
+
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if !equalInts(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if len(pages.fetches) != 2 {
+		t.Errorf("fetch count = %d, want 2 (no trailing empty-page fetch)", len(pages.fetches))
+	}
+}
+
+func TestPageIteratorMaxStopsBeforeFilterExhaustsSource(t *testing.T) {
+	pages := &fakePages{items: []int{1, 2, 3, 4, 5, 6}}
+
+	even := func(i int) bool { return i%2 == 0 }
+	it := newPageIterator(context.Background(), 0, 2, 2, even, pages.fetch)
+
+	got, err := drain(it)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{2, 4}
+	if !equalInts(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
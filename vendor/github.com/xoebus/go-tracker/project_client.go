@@ -2,6 +2,7 @@ package tracker
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,11 +14,11 @@ import (
 
 type ProjectClient struct {
 	id   int
-	conn connection
+	conn *connection
 }
 
-func (p ProjectClient) Stories(query StoriesQuery) ([]Story, Pagination, error) {
-	request, err := p.createRequest("GET", "/stories", query.Query())
+func (p ProjectClient) Stories(ctx context.Context, query StoriesQuery) ([]Story, Pagination, error) {
+	request, err := p.createRequest(ctx, "GET", "/stories", query.Query())
 	if err != nil {
 		return nil, Pagination{}, err
 	}
@@ -31,76 +32,56 @@ func (p ProjectClient) Stories(query StoriesQuery) ([]Story, Pagination, error)
 	return stories, pagination, err
 }
 
-func (p ProjectClient) Labels() ([]Label, error) {
-	request, err := p.createRequest("GET", "/labels?fields=id%2Cproject_id%2Cname%2Ccounts", nil)
+func (p ProjectClient) Labels(ctx context.Context, query LabelsQuery) ([]Label, Pagination, error) {
+	params := query.Query()
+	params.Set("fields", "id,project_id,name,counts")
+
+	request, err := p.createRequest(ctx, "GET", "/labels", params)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, err
 	}
 
 	var labels []Label
-	_, err = p.conn.Do(request, &labels)
+	pagination, err := p.conn.Do(request, &labels)
 	if err != nil {
-		return nil, err
+		return nil, Pagination{}, err
 	}
 
-	return labels, err
+	return labels, pagination, err
 }
 
-func (p ProjectClient) StoryActivity(storyId int, query ActivityQuery) (activities []Activity, err error) {
+func (p ProjectClient) StoryActivity(ctx context.Context, storyId int, query ActivityQuery) (activities []Activity, pagination Pagination, err error) {
 	url := fmt.Sprintf("/stories/%d/activity", storyId)
 
-	request, err := p.createRequest("GET", url, query.Query())
+	request, err := p.createRequest(ctx, "GET", url, query.Query())
 	if err != nil {
-		return activities, err
+		return activities, Pagination{}, err
 	}
 
-	_, err = p.conn.Do(request, &activities)
-	return activities, err
+	pagination, err = p.conn.Do(request, &activities)
+	return activities, pagination, err
 }
 
-func (p ProjectClient) DeliverStoryWithComment(storyId int, comment string) (Story, error) {
-	story, err := p.DeliverStory(storyId)
+func (p ProjectClient) DeliverStoryWithComment(ctx context.Context, storyId int, comment string) (Story, error) {
+	story, err := p.DeliverStory(ctx, storyId)
 	if err != nil {
 		return Story{}, err
 	}
 
-	url := fmt.Sprintf("/stories/%d/comments", storyId)
-	request, err := p.createRequest("POST", url, nil)
-	if err != nil {
-		return Story{}, err
-	}
-
-	buffer := &bytes.Buffer{}
-	json.NewEncoder(buffer).Encode(Comment{
-		Text: comment,
-	})
-
-	p.addJSONBodyReader(request, buffer)
-
-	_, err = p.conn.Do(request, nil)
-	if err != nil {
+	if _, err := p.CreateStoryComment(ctx, storyId, Comment{Text: comment}); err != nil {
 		return Story{}, err
 	}
 
 	return story, nil
 }
 
-func (p ProjectClient) DeliverStory(storyId int) (Story, error) {
-	url := fmt.Sprintf("/stories/%d", storyId)
-	request, err := p.createRequest("PUT", url, nil)
-	if err != nil {
-		return Story{}, err
-	}
-
-	p.addJSONBody(request, `{"current_state":"delivered"}`)
-
-	var updatedStory Story
-	_, err = p.conn.Do(request, &updatedStory)
-	return updatedStory, err
+// Deprecated: use UpdateStory(ctx, storyId, NewStoryUpdate().CurrentState(StateDelivered)) instead.
+func (p ProjectClient) DeliverStory(ctx context.Context, storyId int) (Story, error) {
+	return p.UpdateStory(ctx, storyId, NewStoryUpdate().CurrentState(StateDelivered))
 }
 
-func (p ProjectClient) CreateStory(story Story) (Story, error) {
-	request, err := p.createRequest("POST", "/stories", nil)
+func (p ProjectClient) CreateStory(ctx context.Context, story Story) (Story, error) {
+	request, err := p.createRequest(ctx, "POST", "/stories", nil)
 	if err != nil {
 		return Story{}, err
 	}
@@ -115,9 +96,9 @@ func (p ProjectClient) CreateStory(story Story) (Story, error) {
 	return createdStory, err
 }
 
-func (p ProjectClient) DeleteStory(storyId int) error {
+func (p ProjectClient) DeleteStory(ctx context.Context, storyId int) error {
 	url := fmt.Sprintf("/stories/%d", storyId)
-	request, err := p.createRequest("DELETE", url, nil)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
@@ -126,9 +107,9 @@ func (p ProjectClient) DeleteStory(storyId int) error {
 	return err
 }
 
-func (p ProjectClient) DeleteLabel(labelId int) error {
+func (p ProjectClient) DeleteLabel(ctx context.Context, labelId int) error {
 	url := fmt.Sprintf("/labels/%d", labelId)
-	request, err := p.createRequest("DELETE", url, nil)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
@@ -137,9 +118,9 @@ func (p ProjectClient) DeleteLabel(labelId int) error {
 	return err
 }
 
-func (p ProjectClient) AddStoryLabel(storyId int, label string) (Label, error) {
+func (p ProjectClient) AddStoryLabel(ctx context.Context, storyId int, label string) (Label, error) {
 	url := fmt.Sprintf("/stories/%d/labels", storyId)
-	request, err := p.createRequest("POST", url, nil)
+	request, err := p.createRequest(ctx, "POST", url, nil)
 	if err != nil {
 		return Label{}, err
 	}
@@ -156,9 +137,9 @@ func (p ProjectClient) AddStoryLabel(storyId int, label string) (Label, error) {
 	return createdLabel, err
 }
 
-func (p ProjectClient) RemoveStoryLabel(storyId int, labelId int) error {
+func (p ProjectClient) RemoveStoryLabel(ctx context.Context, storyId int, labelId int) error {
 	url := fmt.Sprintf("/stories/%d/labels/%d", storyId, labelId)
-	request, err := p.createRequest("DELETE", url, nil)
+	request, err := p.createRequest(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
@@ -167,46 +148,49 @@ func (p ProjectClient) RemoveStoryLabel(storyId int, labelId int) error {
 	return err
 }
 
-func (p ProjectClient) SetStoryType(storyId int, storyType StoryType) (Story, error) {
-	url := fmt.Sprintf("/stories/%d", storyId)
-	request, err := p.createRequest("PUT", url, nil)
-	if err != nil {
-		return Story{}, err
-	}
-
-	p.addJSONBody(request, fmt.Sprintf(`{"story_type":%q}`, storyType))
-
-	var updatedStory Story
-	_, err = p.conn.Do(request, &updatedStory)
-	return updatedStory, err
+// Deprecated: use UpdateStory(ctx, storyId, NewStoryUpdate().StoryType(storyType)) instead.
+func (p ProjectClient) SetStoryType(ctx context.Context, storyId int, storyType StoryType) (Story, error) {
+	return p.UpdateStory(ctx, storyId, NewStoryUpdate().StoryType(storyType))
 }
 
-func (p ProjectClient) ProjectMemberships() ([]ProjectMembership, error) {
-	request, err := p.createRequest("GET", "/memberships", nil)
+func (p ProjectClient) ProjectMemberships(ctx context.Context, query MembershipsQuery) ([]ProjectMembership, Pagination, error) {
+	request, err := p.createRequest(ctx, "GET", "/memberships", query.Query())
 	if err != nil {
-		return []ProjectMembership{}, err
+		return []ProjectMembership{}, Pagination{}, err
 	}
 
 	var memberships []ProjectMembership
-	_, err = p.conn.Do(request, &memberships)
+	pagination, err := p.conn.Do(request, &memberships)
 	if err != nil {
-		return []ProjectMembership{}, err
+		return []ProjectMembership{}, Pagination{}, err
 	}
 
-	return memberships, nil
+	return memberships, pagination, nil
+}
+
+// Deprecated: use UpdateStory(ctx, storyId, NewStoryUpdate().Name(name)) instead.
+func (p ProjectClient) SetStoryName(ctx context.Context, storyId int, name string) (Story, error) {
+	return p.UpdateStory(ctx, storyId, NewStoryUpdate().Name(name))
+}
+
+// Deprecated: use UpdateStory(ctx, storyId, NewStoryUpdate().CurrentState(StateUnscheduled)) instead.
+func (p ProjectClient) UnscheduleStory(ctx context.Context, storyId int) (Story, error) {
+	return p.UpdateStory(ctx, storyId, NewStoryUpdate().CurrentState(StateUnscheduled))
 }
 
-func (p ProjectClient) SetStoryName(storyId int, name string) (Story, error) {
+// UpdateStory PUTs only the fields accumulated on u, leaving every other
+// field of the story untouched.
+func (p ProjectClient) UpdateStory(ctx context.Context, storyId int, u StoryUpdate) (Story, error) {
 	url := fmt.Sprintf("/stories/%d", storyId)
-	request, err := p.createRequest("PUT", url, nil)
+	request, err := p.createRequest(ctx, "PUT", url, nil)
 	if err != nil {
 		return Story{}, err
 	}
 
-	storyPayload := Story{Name: name}
-
 	buffer := &bytes.Buffer{}
-	json.NewEncoder(buffer).Encode(storyPayload)
+	if err := json.NewEncoder(buffer).Encode(u); err != nil {
+		return Story{}, err
+	}
 
 	p.addJSONBodyReader(request, buffer)
 
@@ -215,28 +199,30 @@ func (p ProjectClient) SetStoryName(storyId int, name string) (Story, error) {
 	return updatedStory, err
 }
 
-func (p ProjectClient) UnscheduleStory(storyId int) (Story, error) {
-	url := fmt.Sprintf("/stories/%d", storyId)
-	request, err := p.createRequest("PUT", url, nil)
+func (p ProjectClient) createRequest(ctx context.Context, method string, path string, params url.Values) (*http.Request, error) {
+	projectPath := fmt.Sprintf("/projects/%d%s", p.id, path)
+	request, err := p.conn.CreateRequest(method, projectPath, params)
 	if err != nil {
-		return Story{}, err
+		return nil, err
 	}
 
-	p.addJSONBody(request, `{"current_state":"unscheduled"}`)
-
-	var updatedStory Story
-	_, err = p.conn.Do(request, &updatedStory)
-	return updatedStory, err
-}
-
-func (p ProjectClient) createRequest(method string, path string, params url.Values) (*http.Request, error) {
-	projectPath := fmt.Sprintf("/projects/%d%s", p.id, path)
-	return p.conn.CreateRequest(method, projectPath, params)
+	return request.WithContext(ctx), nil
 }
 
 func (p ProjectClient) addJSONBodyReader(request *http.Request, body io.Reader) {
+	var data []byte
+	if buf, ok := body.(*bytes.Buffer); ok {
+		data = buf.Bytes()
+	} else {
+		data, _ = ioutil.ReadAll(body)
+	}
+
 	request.Header.Add("Content-Type", "application/json")
-	request.Body = ioutil.NopCloser(body)
+	request.ContentLength = int64(len(data))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+	request.Body, _ = request.GetBody()
 }
 
 func (p ProjectClient) addJSONBody(request *http.Request, body string) {
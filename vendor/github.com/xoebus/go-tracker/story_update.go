@@ -0,0 +1,76 @@
+package tracker
+
+import "encoding/json"
+
+// StoryState is one of Tracker's current_state values for a story.
+type StoryState string
+
+const (
+	StateUnscheduled StoryState = "unscheduled"
+	StateUnstarted   StoryState = "unstarted"
+	StatePlanned     StoryState = "planned"
+	StateStarted     StoryState = "started"
+	StateFinished    StoryState = "finished"
+	StateDelivered   StoryState = "delivered"
+	StateAccepted    StoryState = "accepted"
+	StateRejected    StoryState = "rejected"
+)
+
+// StoryUpdate accumulates only the fields a caller actually sets, so that
+// ProjectClient.UpdateStory sends a partial PUT instead of clobbering every
+// field with its zero value. Build one with NewStoryUpdate and chain the
+// setters for the fields you want to change:
+//
+//	tracker.NewStoryUpdate().Name("x").CurrentState(tracker.StateAccepted)
+type StoryUpdate struct {
+	fields map[string]interface{}
+}
+
+// NewStoryUpdate returns an empty StoryUpdate ready to have fields chained
+// onto it.
+func NewStoryUpdate() StoryUpdate {
+	return StoryUpdate{fields: map[string]interface{}{}}
+}
+
+func (u StoryUpdate) set(key string, value interface{}) StoryUpdate {
+	next := make(map[string]interface{}, len(u.fields)+1)
+	for k, v := range u.fields {
+		next[k] = v
+	}
+
+	next[key] = value
+
+	return StoryUpdate{fields: next}
+}
+
+func (u StoryUpdate) Name(name string) StoryUpdate {
+	return u.set("name", name)
+}
+
+func (u StoryUpdate) Description(description string) StoryUpdate {
+	return u.set("description", description)
+}
+
+func (u StoryUpdate) CurrentState(state StoryState) StoryUpdate {
+	return u.set("current_state", state)
+}
+
+func (u StoryUpdate) StoryType(storyType StoryType) StoryUpdate {
+	return u.set("story_type", storyType)
+}
+
+func (u StoryUpdate) Estimate(points int) StoryUpdate {
+	return u.set("estimate", points)
+}
+
+func (u StoryUpdate) Labels(labels []string) StoryUpdate {
+	return u.set("labels", labels)
+}
+
+func (u StoryUpdate) OwnerIDs(ids []int) StoryUpdate {
+	return u.set("owner_ids", ids)
+}
+
+func (u StoryUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.fields)
+}